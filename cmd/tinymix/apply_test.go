@@ -0,0 +1,99 @@
+package main
+
+import (
+	"testing"
+
+	"pulpoff/gsm2sip/pkg/alsactl"
+)
+
+// alsactlControlWithCount builds a Control whose only field resolveApplyValue
+// reads, Info.Count, is set — no real card is needed to test pure value math.
+func alsactlControlWithCount(count uint32) alsactl.Control {
+	return alsactl.Control{Info: alsactl.ElemInfo{Count: count}}
+}
+
+func TestParseApplyScript(t *testing.T) {
+	script := []byte(`# comment, then a blank line
+
+'Headphone Volume' = 80
+Master = 50,60
+wait 100
+require 'Headphone Switch' == on
+`)
+	ops, err := parseApplyScript(script)
+	if err != nil {
+		t.Fatalf("parseApplyScript: %v", err)
+	}
+	want := []applyOp{
+		{kind: "set", line: 3, name: "Headphone Volume", value: "80"},
+		{kind: "set", line: 4, name: "Master", value: "50,60"},
+		{kind: "wait", line: 5, ms: 100},
+		{kind: "require", line: 6, name: "Headphone Switch", value: "on"},
+	}
+	if len(ops) != len(want) {
+		t.Fatalf("got %d ops, want %d: %+v", len(ops), len(want), ops)
+	}
+	for i, w := range want {
+		if ops[i] != w {
+			t.Errorf("op %d = %+v, want %+v", i, ops[i], w)
+		}
+	}
+}
+
+func TestParseApplyScriptErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		script string
+	}{
+		{"bad wait duration", "wait abc\n"},
+		{"negative wait duration", "wait -5\n"},
+		{"malformed require", "require Foo bar\n"},
+		{"malformed assignment", "just a name, no equals\n"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := parseApplyScript([]byte(c.script)); err == nil {
+				t.Errorf("parseApplyScript(%q): want error, got nil", c.script)
+			}
+		})
+	}
+}
+
+func TestResolveApplyValueBroadcast(t *testing.T) {
+	ctl := alsactlControlWithCount(3)
+	got := resolveApplyValue(ctl, " 80 ")
+	want := []string{"80", "80", "80"}
+	if !equalStrings(got, want) {
+		t.Errorf("resolveApplyValue(single, count 3) = %v, want %v", got, want)
+	}
+}
+
+func TestResolveApplyValuePerChannel(t *testing.T) {
+	ctl := alsactlControlWithCount(3)
+	got := resolveApplyValue(ctl, "10, 20,30")
+	want := []string{"10", "20", "30"}
+	if !equalStrings(got, want) {
+		t.Errorf("resolveApplyValue(per-channel) = %v, want %v", got, want)
+	}
+}
+
+func TestResolveApplyValueSingleChannel(t *testing.T) {
+	ctl := alsactlControlWithCount(1)
+	got := resolveApplyValue(ctl, "on")
+	want := []string{"on"}
+	if !equalStrings(got, want) {
+		t.Errorf("resolveApplyValue(count 1) = %v, want %v", got, want)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,698 @@
+// Command tinymix is a minimal ALSA mixer CLI, cross-compiled in Go with no
+// libtinyalsa/libasound dependency. It is a thin wrapper over pkg/alsactl —
+// all ioctl and struct-layout details live there; this file is just argument
+// parsing, formatting, and the save/restore/apply file formats.
+//
+// Usage:
+//
+//	tinymix                     — list all controls
+//	tinymix <id>                — get control by numeric ID
+//	tinymix <id> <value>        — set control by numeric ID
+//	tinymix '<name>'            — get control by name
+//	tinymix '<name>' <value>    — set control by name (enum by name or index)
+//	tinymix -e '<name>'         — list enum items (diagnostic)
+//	tinymix -t <id>             — show raw value alongside its dB equivalent
+//	tinymix event|monitor       — stream mixer control change events
+//	tinymix event --json       — same, one JSON object per line
+//	tinymix save <file>          — snapshot every control's value to <file>
+//	tinymix restore <file>       — re-apply a profile saved with 'save'
+//	tinymix apply <script>       — run a 'name = value' / wait / require script
+//	tinymix apply <script> --dry-run  — print what would change, write nothing
+//	tinymix apply <script> --diff     — skip writes already at the target value
+//	tinymix -D <card> ...       — use specific ALSA card
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"pulpoff/gsm2sip/pkg/alsactl"
+)
+
+// formatDBRange describes an integer control's dB range, e.g.
+// "[-2050..0 dB, step 50]", by reading its TLV. Returns "" if the control
+// has no TLV_READ access or the TLV type isn't one we decode.
+func formatDBRange(card *alsactl.Card, ctl alsactl.Control) string {
+	if ctl.Info.Type != alsactl.ElemTypeInteger || !ctl.HasTLV() {
+		return ""
+	}
+	tlvType, payload, err := card.ReadTLV(ctl)
+	if err != nil || len(payload) < 2 {
+		return ""
+	}
+	return dbRangeString(tlvType, payload, ctl.Info.IntMin(), ctl.Info.IntMax())
+}
+
+// dbRangeString is the pure math behind formatDBRange, split out so it can
+// be unit-tested without a real TLV_READ ioctl.
+func dbRangeString(tlvType uint32, payload []uint32, min, max int64) string {
+	switch tlvType {
+	case alsactl.TLVTypeDBScale:
+		minDB := int32(payload[0])
+		step := int32(int16(payload[1] & 0xffff))
+		maxDB := minDB + step*int32(max-min)
+		return fmt.Sprintf("[%d..%d dB, step %d]", minDB, maxDB, step)
+	case alsactl.TLVTypeDBLinear, alsactl.TLVTypeDBMinMax:
+		return fmt.Sprintf("[%d..%d dB]", int32(payload[0]), int32(payload[1]))
+	default:
+		return ""
+	}
+}
+
+// formatDBValue converts a control's raw integer value to its dB equivalent
+// using its TLV, for the -t flag. ok is false if no TLV could be decoded.
+func formatDBValue(card *alsactl.Card, ctl alsactl.Control, raw int64) (s string, ok bool) {
+	if !ctl.HasTLV() {
+		return "", false
+	}
+	tlvType, payload, err := card.ReadTLV(ctl)
+	if err != nil || len(payload) < 2 {
+		return "", false
+	}
+	return dbValueString(tlvType, payload, ctl.Info.IntMin(), ctl.Info.IntMax(), raw)
+}
+
+// dbValueString is the pure math behind formatDBValue, split out so it can
+// be unit-tested without a real TLV_READ ioctl.
+func dbValueString(tlvType uint32, payload []uint32, min, max, raw int64) (s string, ok bool) {
+	switch tlvType {
+	case alsactl.TLVTypeDBScale:
+		minDB := int64(int32(payload[0]))
+		step := int64(int16(payload[1] & 0xffff))
+		mute := payload[1]&0x10000 != 0
+		if mute && raw == min {
+			return "-inf dB", true
+		}
+		centi := minDB + step*(raw-min)
+		return fmt.Sprintf("%.2f dB", float64(centi)/100.0), true
+	case alsactl.TLVTypeDBLinear, alsactl.TLVTypeDBMinMax:
+		minDB, maxDB := int64(int32(payload[0])), int64(int32(payload[1]))
+		span := max - min
+		if span == 0 {
+			return fmt.Sprintf("%.2f dB", float64(minDB)/100.0), true
+		}
+		centi := minDB + (maxDB-minDB)*(raw-min)/span
+		return fmt.Sprintf("%.2f dB", float64(centi)/100.0), true
+	default:
+		return "", false
+	}
+}
+
+// formatValue renders a control's current value for display, e.g.
+// "On Off", or "42 [-2050..0 dB, step 50]" for an INTEGER control whose TLV
+// gives its dB range.
+func formatValue(card *alsactl.Card, ctl alsactl.Control, val alsactl.ElemValue) string {
+	parts := make([]string, 0, ctl.Info.Count)
+	for i := uint32(0); i < ctl.Info.Count && i < 128; i++ {
+		switch ctl.Info.Type {
+		case alsactl.ElemTypeBoolean:
+			if val.Long(int(i)) != 0 {
+				parts = append(parts, "On")
+			} else {
+				parts = append(parts, "Off")
+			}
+		case alsactl.ElemTypeInteger:
+			parts = append(parts, fmt.Sprintf("%d", val.Long(int(i))))
+		case alsactl.ElemTypeEnumerated:
+			v := val.Uint32(int(i))
+			if items, err := card.EnumItems(ctl); err == nil && int(v) < len(items) && items[v] != "" {
+				parts = append(parts, items[v])
+			} else {
+				parts = append(parts, fmt.Sprintf("%d", v))
+			}
+		case alsactl.ElemTypeBytes:
+			parts = append(parts, fmt.Sprintf("0x%02x", val.Byte(int(i))))
+		case alsactl.ElemTypeInteger64:
+			parts = append(parts, fmt.Sprintf("%d", val.Int64(int(i))))
+		default:
+			parts = append(parts, "?")
+		}
+	}
+	out := strings.Join(parts, " ")
+	if ctl.Info.Type == alsactl.ElemTypeInteger {
+		if rng := formatDBRange(card, ctl); rng != "" {
+			out += " " + rng
+		}
+	}
+	return out
+}
+
+// formatValueDB is like formatValue for an INTEGER control, but prints each
+// raw value alongside its dB equivalent, e.g. "80 [-10.00 dB]". Used by -t.
+func formatValueDB(card *alsactl.Card, ctl alsactl.Control, val alsactl.ElemValue) string {
+	parts := make([]string, 0, ctl.Info.Count)
+	for i := uint32(0); i < ctl.Info.Count && i < 128; i++ {
+		raw := val.Long(int(i))
+		if db, ok := formatDBValue(card, ctl, raw); ok {
+			parts = append(parts, fmt.Sprintf("%d [%s]", raw, db))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d", raw))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// valuesOf returns a control's current value as parseable strings — one per
+// channel, BOOL as "on"/"off", ENUM by name (falling back to its numeric
+// index), BYTES/IEC958 as hex. This is the save/restore counterpart of
+// formatValue, which is for display rather than round-tripping.
+func valuesOf(card *alsactl.Card, ctl alsactl.Control, val alsactl.ElemValue) []string {
+	vals := make([]string, 0, ctl.Info.Count)
+	switch ctl.Info.Type {
+	case alsactl.ElemTypeBoolean:
+		for i := uint32(0); i < ctl.Info.Count && i < 128; i++ {
+			if val.Long(int(i)) != 0 {
+				vals = append(vals, "on")
+			} else {
+				vals = append(vals, "off")
+			}
+		}
+	case alsactl.ElemTypeInteger:
+		for i := uint32(0); i < ctl.Info.Count && i < 128; i++ {
+			vals = append(vals, strconv.FormatInt(val.Long(int(i)), 10))
+		}
+	case alsactl.ElemTypeInteger64:
+		for i := uint32(0); i < ctl.Info.Count && i < 64; i++ {
+			vals = append(vals, strconv.FormatInt(val.Int64(int(i)), 10))
+		}
+	case alsactl.ElemTypeEnumerated:
+		items, _ := card.EnumItems(ctl)
+		for i := uint32(0); i < ctl.Info.Count && i < 128; i++ {
+			v := val.Uint32(int(i))
+			if int(v) < len(items) && items[v] != "" {
+				vals = append(vals, items[v])
+			} else {
+				vals = append(vals, strconv.FormatUint(uint64(v), 10))
+			}
+		}
+	case alsactl.ElemTypeBytes:
+		for i := uint32(0); i < ctl.Info.Count && i < 512; i++ {
+			vals = append(vals, fmt.Sprintf("%02x", val.Byte(int(i))))
+		}
+	case alsactl.ElemTypeIEC958:
+		// iec958.status[24] — see the IEC958 arm of the value union.
+		for i := 0; i < 24; i++ {
+			vals = append(vals, fmt.Sprintf("%02x", val.Byte(i)))
+		}
+	}
+	return vals
+}
+
+func listControls(card *alsactl.Card) error {
+	ctls, err := card.List()
+	if err != nil {
+		return err
+	}
+	if len(ctls) == 0 {
+		fmt.Println("No mixer controls found")
+		return nil
+	}
+
+	fmt.Printf("Number of controls: %d\n", len(ctls))
+	for _, ctl := range ctls {
+		val, err := card.Get(ctl)
+		if err != nil {
+			fmt.Printf("%-4d\t%-4s\t%-2d\t%-44s\t(read error)\n",
+				ctl.Numid(), alsactl.TypeName(ctl.Type()), ctl.Info.Count, ctl.Name())
+			continue
+		}
+		fmt.Printf("%-4d\t%-4s\t%-2d\t%-44s\t%s\n",
+			ctl.Numid(), alsactl.TypeName(ctl.Type()), ctl.Info.Count, ctl.Name(),
+			formatValue(card, ctl, val))
+	}
+	return nil
+}
+
+// dumpEnumItems lists an ENUM control's item names, with its current value,
+// for diagnosing controls whose names don't resolve as expected.
+func dumpEnumItems(card *alsactl.Card, ctl alsactl.Control) {
+	items, err := card.EnumItems(ctl)
+	fmt.Printf("Control '%s' (numid=%d): ENUM, %d items, %d values\n",
+		ctl.Name(), ctl.Numid(), len(items), ctl.Info.Count)
+	if err != nil {
+		fmt.Printf("  error: %v\n", err)
+		return
+	}
+	for i, name := range items {
+		if name == "" {
+			fmt.Printf("  [%d] (empty name)\n", i)
+		} else {
+			fmt.Printf("  [%d] %s\n", i, name)
+		}
+	}
+	if val, err := card.Get(ctl); err == nil {
+		fmt.Printf("Current value: %s\n", formatValue(card, ctl, val))
+	}
+}
+
+// controlEvent is the --json line shape for one mixer event.
+type controlEvent struct {
+	Numid uint32 `json:"numid"`
+	Name  string `json:"name"`
+	Mask  string `json:"mask"`
+	Value string `json:"value,omitempty"`
+}
+
+// monitorEvents subscribes card for control-change events and prints them
+// forever, one per notification, until the read fails (e.g. fd closed).
+func monitorEvents(card *alsactl.Card, jsonOut bool) error {
+	if err := card.SubscribeEvents(true); err != nil {
+		return fmt.Errorf("subscribe events: %v", err)
+	}
+	defer card.SubscribeEvents(false)
+
+	enc := json.NewEncoder(os.Stdout)
+	for {
+		ev, err := card.ReadEvent()
+		if err != nil {
+			return fmt.Errorf("read event: %v", err)
+		}
+		if ev.Type != alsactl.EventElem {
+			continue
+		}
+
+		ce := controlEvent{Numid: ev.ID.Numid, Name: ev.Name(), Mask: alsactl.MaskString(ev.Mask)}
+		if ev.Mask != alsactl.EventMaskRemove {
+			if ctl, err := card.ByNumid(ev.ID.Numid); err == nil {
+				if val, err := card.Get(ctl); err == nil {
+					ce.Value = formatValue(card, ctl, val)
+				}
+			}
+		}
+
+		if jsonOut {
+			enc.Encode(ce)
+			continue
+		}
+		if ce.Value != "" {
+			fmt.Printf("numid=%d %s: %s [%s]\n", ce.Numid, ce.Name, ce.Value, ce.Mask)
+		} else {
+			fmt.Printf("numid=%d %s [%s]\n", ce.Numid, ce.Name, ce.Mask)
+		}
+	}
+}
+
+// controlState is one control's entry in a save/restore profile.
+type controlState struct {
+	Name   string   `json:"name"`
+	Index  uint32   `json:"index"`
+	Type   string   `json:"type"`
+	Values []string `json:"values"`
+}
+
+// saveMixer snapshots every readable control's current value to path as a
+// JSON profile, keyed by control name + index.
+func saveMixer(card *alsactl.Card, path string) error {
+	ctls, err := card.List()
+	if err != nil {
+		return err
+	}
+
+	states := make([]controlState, 0, len(ctls))
+	for _, ctl := range ctls {
+		val, err := card.Get(ctl)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "save: %s (numid=%d): read: %v\n", ctl.Name(), ctl.Numid(), err)
+			continue
+		}
+		states = append(states, controlState{
+			Name:   ctl.Name(),
+			Index:  ctl.Index(),
+			Type:   alsactl.TypeName(ctl.Type()),
+			Values: valuesOf(card, ctl, val),
+		})
+	}
+
+	data, err := json.MarshalIndent(states, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode profile: %v", err)
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// restoreMixer re-applies a profile written by saveMixer. Controls no longer
+// present are skipped with a message, read-only controls silently; a write
+// failure on one control does not stop the rest from being applied.
+func restoreMixer(card *alsactl.Card, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read profile: %v", err)
+	}
+	var states []controlState
+	if err := json.Unmarshal(data, &states); err != nil {
+		return fmt.Errorf("decode profile: %v", err)
+	}
+
+	for _, st := range states {
+		ctl, err := card.ByNameIndex(st.Name, st.Index)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "restore: %s[%d]: not found, skipping\n", st.Name, st.Index)
+			continue
+		}
+		if !ctl.Writable() {
+			continue // read-only, nothing to restore
+		}
+
+		var cur []string
+		if val, err := card.Get(ctl); err == nil {
+			cur = valuesOf(card, ctl, val)
+		}
+		if strings.Join(cur, ",") == strings.Join(st.Values, ",") {
+			continue // already at the target value
+		}
+
+		if err := card.Set(ctl, st.Values); err != nil {
+			fmt.Fprintf(os.Stderr, "restore: %s[%d]: %v\n", st.Name, st.Index, err)
+			continue
+		}
+		fmt.Printf("%s[%d]: %s -> %s\n", st.Name, st.Index, strings.Join(cur, " "), strings.Join(st.Values, " "))
+	}
+	return nil
+}
+
+// applyOp is one line of an apply script, already parsed.
+type applyOp struct {
+	kind  string // "set", "wait", "require"
+	line  int    // 1-based, for error messages
+	name  string
+	value string
+	ms    int
+}
+
+// unquoteApply strips a matching pair of surrounding quotes, the same way
+// a shell would, so control names can be written as 'Headphone Volume'.
+func unquoteApply(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 {
+		if (s[0] == '\'' && s[len(s)-1] == '\'') || (s[0] == '"' && s[len(s)-1] == '"') {
+			return s[1 : len(s)-1]
+		}
+	}
+	return s
+}
+
+// parseApplyScript parses the 'tinymix apply' DSL: one 'name = value' per
+// line, '#' comments, 'wait <ms>', and 'require <name> == <value>' guards.
+func parseApplyScript(data []byte) ([]applyOp, error) {
+	lines := strings.Split(string(data), "\n")
+	ops := make([]applyOp, 0, len(lines))
+	for i, raw := range lines {
+		line := strings.TrimSpace(raw)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lineNo := i + 1
+		switch {
+		case strings.HasPrefix(line, "wait "):
+			msStr := strings.TrimSpace(strings.TrimPrefix(line, "wait "))
+			ms, err := strconv.Atoi(msStr)
+			if err != nil || ms < 0 {
+				return nil, fmt.Errorf("line %d: invalid wait duration %q", lineNo, msStr)
+			}
+			ops = append(ops, applyOp{kind: "wait", line: lineNo, ms: ms})
+
+		case strings.HasPrefix(line, "require "):
+			rest := strings.TrimPrefix(line, "require ")
+			parts := strings.SplitN(rest, "==", 2)
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("line %d: malformed require (want 'require <name> == <value>')", lineNo)
+			}
+			ops = append(ops, applyOp{
+				kind: "require", line: lineNo,
+				name: unquoteApply(parts[0]), value: unquoteApply(parts[1]),
+			})
+
+		default:
+			parts := strings.SplitN(line, "=", 2)
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("line %d: malformed assignment (want 'name = value')", lineNo)
+			}
+			ops = append(ops, applyOp{
+				kind: "set", line: lineNo,
+				name: unquoteApply(parts[0]), value: unquoteApply(parts[1]),
+			})
+		}
+	}
+	return ops, nil
+}
+
+// resolveApplyValue turns a script value into one string per channel: a
+// comma-separated value sets each channel independently, a bare value is
+// broadcast to every channel (mirroring 'tinymix <name> <value>').
+func resolveApplyValue(ctl alsactl.Control, raw string) []string {
+	parts := strings.Split(raw, ",")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	if len(parts) == 1 && ctl.Info.Count > 1 {
+		expanded := make([]string, ctl.Info.Count)
+		for i := range expanded {
+			expanded[i] = parts[0]
+		}
+		return expanded
+	}
+	return parts
+}
+
+// touchedControl records a control's pre-apply value so applyScript can
+// roll it back if a later op in the same run fails.
+type touchedControl struct {
+	ctl  alsactl.Control
+	prev []string
+}
+
+// applyScript runs a parsed script in one pass without reopening the card.
+// dryRun prints the resolved target values without writing; diffOnly skips
+// writes whose current value already matches. If any op fails partway
+// through, every control touched earlier in the run is restored to its
+// pre-run value before the error is returned.
+func applyScript(card *alsactl.Card, ops []applyOp, dryRun, diffOnly bool) error {
+	var touched []touchedControl
+	rollback := func() {
+		for i := len(touched) - 1; i >= 0; i-- {
+			t := touched[i]
+			if err := card.Set(t.ctl, t.prev); err != nil {
+				fmt.Fprintf(os.Stderr, "apply: rollback %s: %v\n", t.ctl.Name(), err)
+			}
+		}
+	}
+
+	for _, op := range ops {
+		switch op.kind {
+		case "wait":
+			if dryRun {
+				fmt.Printf("line %d: wait %dms\n", op.line, op.ms)
+				continue
+			}
+			time.Sleep(time.Duration(op.ms) * time.Millisecond)
+
+		case "require":
+			ctl, err := card.ByName(op.name)
+			if err != nil {
+				rollback()
+				return fmt.Errorf("line %d: require %s: control not found", op.line, op.name)
+			}
+			val, err := card.Get(ctl)
+			if err != nil {
+				rollback()
+				return fmt.Errorf("line %d: require %s: %v", op.line, op.name, err)
+			}
+			cur := strings.Join(valuesOf(card, ctl, val), " ")
+			if !strings.EqualFold(cur, op.value) {
+				rollback()
+				return fmt.Errorf("line %d: require %s == %s: got %s", op.line, op.name, op.value, cur)
+			}
+
+		case "set":
+			ctl, err := card.ByName(op.name)
+			if err != nil {
+				rollback()
+				return fmt.Errorf("line %d: %s: control not found", op.line, op.name)
+			}
+			val, err := card.Get(ctl)
+			if err != nil {
+				rollback()
+				return fmt.Errorf("line %d: %s: read: %v", op.line, op.name, err)
+			}
+			cur := valuesOf(card, ctl, val)
+			target := resolveApplyValue(ctl, op.value)
+
+			if diffOnly && strings.Join(cur, ",") == strings.Join(target, ",") {
+				continue
+			}
+			if dryRun {
+				fmt.Printf("%s (numid=%d, %s): %s -> %s\n",
+					op.name, ctl.Numid(), alsactl.TypeName(ctl.Type()), strings.Join(cur, " "), strings.Join(target, " "))
+				continue
+			}
+			if err := card.Set(ctl, target); err != nil {
+				rollback()
+				return fmt.Errorf("line %d: %s: write: %v", op.line, op.name, err)
+			}
+			touched = append(touched, touchedControl{ctl: ctl, prev: cur})
+			fmt.Printf("%s: %s -> %s\n", op.name, strings.Join(cur, " "), strings.Join(target, " "))
+		}
+	}
+	return nil
+}
+
+func main() {
+	cardNum := 0
+	args := os.Args[1:]
+
+	// Parse options: -D card, -e (enum dump), -t (show dB equivalent)
+	enumDump := false
+	showDB := false
+	for i := 0; i < len(args); i++ {
+		if args[i] == "-D" && i+1 < len(args) {
+			c, err := strconv.Atoi(args[i+1])
+			if err == nil {
+				cardNum = c
+				args = append(args[:i], args[i+2:]...)
+				i-- // re-check this index
+			}
+		} else if args[i] == "-e" {
+			enumDump = true
+			args = append(args[:i], args[i+1:]...)
+			i--
+		} else if args[i] == "-t" {
+			showDB = true
+			args = append(args[:i], args[i+1:]...)
+			i--
+		}
+	}
+
+	card, err := alsactl.Open(cardNum)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	defer card.Close()
+
+	if len(args) > 0 && (args[0] == "event" || args[0] == "monitor") {
+		jsonOut := false
+		for _, a := range args[1:] {
+			if a == "--json" {
+				jsonOut = true
+			}
+		}
+		if err := monitorEvents(card, jsonOut); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(args) >= 2 && args[0] == "apply" {
+		dryRun, diffOnly := false, false
+		for _, a := range args[2:] {
+			switch a {
+			case "--dry-run":
+				dryRun = true
+			case "--diff":
+				diffOnly = true
+			}
+		}
+		data, err := os.ReadFile(args[1])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		ops, err := parseApplyScript(data)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if err := applyScript(card, ops, dryRun, diffOnly); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(args) >= 2 && (args[0] == "save" || args[0] == "restore") {
+		var err error
+		if args[0] == "save" {
+			err = saveMixer(card, args[1])
+		} else {
+			err = restoreMixer(card, args[1])
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(args) == 0 {
+		if err := listControls(card); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Get or set a control
+	controlName := args[0]
+	var ctl alsactl.Control
+
+	// Try as numeric ID first
+	if numid, err := strconv.ParseUint(controlName, 10, 32); err == nil {
+		ctl, err = card.ByNumid(uint32(numid))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: control %d not found: %v\n", numid, err)
+			os.Exit(1)
+		}
+	} else {
+		ctl, err = card.ByName(controlName)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: control '%s' not found\n", controlName)
+			os.Exit(1)
+		}
+	}
+
+	// -e: dump enum items and exit
+	if enumDump {
+		if ctl.Type() != alsactl.ElemTypeEnumerated {
+			fmt.Fprintf(os.Stderr, "Error: control '%s' is %s, not ENUM\n",
+				controlName, alsactl.TypeName(ctl.Type()))
+			os.Exit(1)
+		}
+		dumpEnumItems(card, ctl)
+		return
+	}
+
+	if len(args) == 1 {
+		// Get control value
+		val, err := card.Get(ctl)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading control: %v\n", err)
+			os.Exit(1)
+		}
+		if showDB && ctl.Type() == alsactl.ElemTypeInteger {
+			fmt.Printf("%s: %s\n", ctl.Name(), formatValueDB(card, ctl, val))
+		} else {
+			fmt.Printf("%s: %s\n", ctl.Name(), formatValue(card, ctl, val))
+		}
+	} else {
+		// Set control value, broadcasting a single value to every channel
+		// (mirroring "name = value" in an apply script — see resolveApplyValue).
+		values := make([]string, ctl.Info.Count)
+		for i := range values {
+			values[i] = args[1]
+		}
+		if err := card.Set(ctl, values); err != nil {
+			fmt.Fprintf(os.Stderr, "Error setting control '%s' to '%s': %v\n",
+				controlName, args[1], err)
+			os.Exit(1)
+		}
+		// Read back
+		if val, err := card.Get(ctl); err == nil {
+			fmt.Printf("%s: %s\n", ctl.Name(), formatValue(card, ctl, val))
+		}
+	}
+}
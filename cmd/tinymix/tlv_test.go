@@ -0,0 +1,119 @@
+package main
+
+import (
+	"testing"
+
+	"pulpoff/gsm2sip/pkg/alsactl"
+)
+
+// u32 reinterprets a negative dB value as the raw uint32 TLV word would
+// carry it, the same way card.ReadTLV hands back payload words.
+func u32(v int32) uint32 { return uint32(v) }
+
+func TestDBRangeString(t *testing.T) {
+	cases := []struct {
+		name     string
+		tlvType  uint32
+		payload  []uint32
+		min, max int64
+		want     string
+	}{
+		{
+			name:    "dB scale, -20.50..0 dB step 0.50",
+			tlvType: alsactl.TLVTypeDBScale,
+			payload: []uint32{u32(-2050), 50},
+			min:     0, max: 41,
+			want: "[-2050..0 dB, step 50]",
+		},
+		{
+			name:    "dB linear",
+			tlvType: alsactl.TLVTypeDBLinear,
+			payload: []uint32{u32(-9600), 0},
+			min:     0, max: 100,
+			want: "[-9600..0 dB]",
+		},
+		{
+			name:    "dB minmax",
+			tlvType: alsactl.TLVTypeDBMinMax,
+			payload: []uint32{u32(-6000), 600},
+			min:     0, max: 10,
+			want: "[-6000..600 dB]",
+		},
+		{
+			name:    "unrecognized TLV type",
+			tlvType: 0xdead,
+			payload: []uint32{0, 0},
+			min:     0, max: 10,
+			want: "",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := dbRangeString(c.tlvType, c.payload, c.min, c.max); got != c.want {
+				t.Errorf("dbRangeString() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestDBValueString(t *testing.T) {
+	cases := []struct {
+		name          string
+		tlvType       uint32
+		payload       []uint32
+		min, max, raw int64
+		want          string
+		wantOK        bool
+	}{
+		{
+			name:    "dB scale mid-range",
+			tlvType: alsactl.TLVTypeDBScale,
+			payload: []uint32{u32(-2050), 50},
+			min:     0, max: 41, raw: 1,
+			want: "-20.00 dB", wantOK: true,
+		},
+		{
+			name:    "dB scale at min with mute bit",
+			tlvType: alsactl.TLVTypeDBScale,
+			payload: []uint32{u32(-2050), 50 | 0x10000},
+			min:     0, max: 41, raw: 0,
+			want: "-inf dB", wantOK: true,
+		},
+		{
+			name:    "dB scale at min without mute bit",
+			tlvType: alsactl.TLVTypeDBScale,
+			payload: []uint32{u32(-2050), 50},
+			min:     0, max: 41, raw: 0,
+			want: "-20.50 dB", wantOK: true,
+		},
+		{
+			name:    "dB linear midpoint",
+			tlvType: alsactl.TLVTypeDBLinear,
+			payload: []uint32{u32(-9600), 0},
+			min:     0, max: 100, raw: 50,
+			want: "-48.00 dB", wantOK: true,
+		},
+		{
+			name:    "dB linear zero span",
+			tlvType: alsactl.TLVTypeDBLinear,
+			payload: []uint32{u32(-9600), u32(-9600)},
+			min:     5, max: 5, raw: 5,
+			want: "-96.00 dB", wantOK: true,
+		},
+		{
+			name:    "unrecognized TLV type",
+			tlvType: 0xdead,
+			payload: []uint32{0, 0},
+			min:     0, max: 10, raw: 0,
+			want: "", wantOK: false,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := dbValueString(c.tlvType, c.payload, c.min, c.max, c.raw)
+			if got != c.want || ok != c.wantOK {
+				t.Errorf("dbValueString() = (%q, %v), want (%q, %v)", got, ok, c.want, c.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Package alsactl talks to the Linux ALSA control (mixer) API directly
+// through ioctls on /dev/snd/controlCN, with no libasound/libtinyalsa
+// dependency. It backs cmd/tinymix, and is meant to be linked directly by
+// daemons (such as the gsm2sip call-routing path) that need to read or
+// change mixer controls without shelling out to a subprocess per call.
+//
+// A *Card is safe for concurrent use: every ioctl on its fd is serialized
+// by an internal mutex.
+package alsactl
+
+import "errors"
+
+// Errors returned by Card methods. Use errors.Is to check for these.
+var (
+	// ErrNotFound is returned by ByName/ByNumid when no control matches.
+	ErrNotFound = errors.New("alsactl: control not found")
+	// ErrReadOnly is returned by Set when the control has no WRITE access.
+	ErrReadOnly = errors.New("alsactl: control is read-only")
+	// ErrWrongType is returned when an operation doesn't apply to the
+	// control's element type (e.g. EnumItems on a non-ENUMERATED control).
+	ErrWrongType = errors.New("alsactl: wrong control type")
+)
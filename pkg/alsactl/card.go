@@ -0,0 +1,285 @@
+package alsactl
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// Card is an open handle to an ALSA control device (/dev/snd/controlCN).
+// It is safe for concurrent use: every ioctl issued on fd is serialized by
+// mu, and enumCache is likewise mutex-guarded.
+type Card struct {
+	fd int
+
+	mu        sync.Mutex
+	enumCache map[uint32][]string // numid -> item names, filled lazily
+}
+
+// Open opens /dev/snd/controlC<card> for reading and writing controls.
+func Open(card int) (*Card, error) {
+	path := fmt.Sprintf("/dev/snd/controlC%d", card)
+	fd, err := syscall.Open(path, syscall.O_RDWR, 0)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %v", path, err)
+	}
+	return &Card{fd: fd, enumCache: make(map[uint32][]string)}, nil
+}
+
+// Close closes the underlying device fd.
+func (c *Card) Close() error {
+	return syscall.Close(c.fd)
+}
+
+func (c *Card) ioctl(req uint, arg unsafe.Pointer) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return ioctl(c.fd, req, arg)
+}
+
+func (c *Card) elemCount() (uint32, error) {
+	var list elemList
+	if err := c.ioctl(sndrvCtlIoctlElemList, unsafe.Pointer(&list)); err != nil {
+		return 0, err
+	}
+	return list.Count, nil
+}
+
+func (c *Card) elemIDs(count uint32) ([]ElemID, error) {
+	if count == 0 {
+		return nil, nil
+	}
+	ids := make([]ElemID, count)
+	var list elemList
+	list.Space = count
+	setListPids(&list, ids)
+	if err := c.ioctl(sndrvCtlIoctlElemList, unsafe.Pointer(&list)); err != nil {
+		return nil, err
+	}
+	return ids[:list.Used], nil
+}
+
+func (c *Card) elemInfo(id ElemID) (ElemInfo, error) {
+	var info ElemInfo
+	info.ID = id
+	err := c.ioctl(sndrvCtlIoctlElemInfo, unsafe.Pointer(&info))
+	return info, err
+}
+
+// List returns every control currently exposed by the card.
+func (c *Card) List() ([]Control, error) {
+	count, err := c.elemCount()
+	if err != nil {
+		return nil, fmt.Errorf("alsactl: ELEM_LIST count: %v", err)
+	}
+	ids, err := c.elemIDs(count)
+	if err != nil {
+		return nil, fmt.Errorf("alsactl: ELEM_LIST ids: %v", err)
+	}
+
+	ctls := make([]Control, 0, len(ids))
+	for _, id := range ids {
+		info, err := c.elemInfo(id)
+		if err != nil {
+			continue // control vanished between LIST and INFO — skip it
+		}
+		ctls = append(ctls, Control{ID: id, Info: info})
+	}
+	return ctls, nil
+}
+
+// ByNumid looks up a control by its numeric ID.
+func (c *Card) ByNumid(numid uint32) (Control, error) {
+	var id ElemID
+	id.Numid = numid
+	info, err := c.elemInfo(id)
+	if err != nil {
+		return Control{}, ErrNotFound
+	}
+	return Control{ID: info.ID, Info: info}, nil
+}
+
+// ByName looks up the first control with the given name. Use List and
+// filter by Index for same-named controls (e.g. per-channel gains).
+func (c *Card) ByName(name string) (Control, error) {
+	ctls, err := c.List()
+	if err != nil {
+		return Control{}, err
+	}
+	for _, ctl := range ctls {
+		if ctl.Name() == name {
+			return ctl, nil
+		}
+	}
+	return Control{}, ErrNotFound
+}
+
+// ByNameIndex looks up a control by name *and* index, to disambiguate
+// several same-named controls (e.g. "PGA Gain" index 0 and 1).
+func (c *Card) ByNameIndex(name string, index uint32) (Control, error) {
+	ctls, err := c.List()
+	if err != nil {
+		return Control{}, err
+	}
+	for _, ctl := range ctls {
+		if ctl.Name() == name && ctl.Index() == index {
+			return ctl, nil
+		}
+	}
+	return Control{}, ErrNotFound
+}
+
+// Get reads a control's current value.
+func (c *Card) Get(ctl Control) (ElemValue, error) {
+	var val ElemValue
+	val.ID = ctl.ID
+	err := c.ioctl(sndrvCtlIoctlElemRead, unsafe.Pointer(&val))
+	return val, err
+}
+
+// EnumItems returns an ENUMERATED control's item names, querying the
+// kernel once per item on first lookup and caching the result by numid so
+// repeated lookups don't re-issue ELEM_INFO.
+func (c *Card) EnumItems(ctl Control) ([]string, error) {
+	if ctl.Info.Type != ElemTypeEnumerated {
+		return nil, ErrWrongType
+	}
+
+	c.mu.Lock()
+	if items, ok := c.enumCache[ctl.ID.Numid]; ok {
+		c.mu.Unlock()
+		return items, nil
+	}
+	c.mu.Unlock()
+
+	n := ctl.Info.enumItems()
+	items := make([]string, n)
+	for i := uint32(0); i < n; i++ {
+		name, err := c.enumItemName(ctl.ID, i)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = name
+	}
+
+	c.mu.Lock()
+	c.enumCache[ctl.ID.Numid] = items
+	c.mu.Unlock()
+	return items, nil
+}
+
+// enumItemName queries a single enum item's name via ELEM_INFO. On some
+// Samsung Exynos/ABOX drivers the name[64] field may come back empty; in
+// that case we return "" and the caller falls back to a numeric display.
+func (c *Card) enumItemName(id ElemID, itemIndex uint32) (string, error) {
+	var info ElemInfo
+	info.ID = id
+	// Set which item to query: enumerated.item at Union[4:8]
+	binary.LittleEndian.PutUint32(info.Union[4:8], itemIndex)
+	if err := c.ioctl(sndrvCtlIoctlElemInfo, unsafe.Pointer(&info)); err != nil {
+		return "", err
+	}
+	// Name is at Union[8:72] (64 bytes) — enumerated.name[64]
+	nameBytes := info.Union[8:72]
+	n := 0
+	for n < len(nameBytes) && nameBytes[n] != 0 {
+		n++
+	}
+	return string(nameBytes[:n]), nil
+}
+
+func (c *Card) findEnumIndex(ctl Control, name string) (uint32, bool) {
+	items, err := c.EnumItems(ctl)
+	if err != nil {
+		return 0, false
+	}
+	for i, item := range items {
+		if strings.EqualFold(item, name) {
+			return uint32(i), true
+		}
+	}
+	return 0, false
+}
+
+// Set writes a control's value, one string per channel: BOOL as
+// "on"/"off"/"1"/"0", INTEGER/INTEGER64 as decimal, ENUMERATED as an item
+// name or numeric index, BYTES/IEC958 as hex ("3f"). A single value is not
+// broadcast to every channel — callers that want that should repeat it.
+func (c *Card) Set(ctl Control, values []string) error {
+	if !ctl.Writable() {
+		return ErrReadOnly
+	}
+
+	var val ElemValue
+	val.ID = ctl.ID
+
+	switch ctl.Info.Type {
+	case ElemTypeBoolean:
+		for i, s := range values {
+			if uint32(i) >= ctl.Info.Count {
+				break
+			}
+			v := int64(0)
+			if s == "1" || strings.EqualFold(s, "on") || strings.EqualFold(s, "true") {
+				v = 1
+			}
+			val.SetLong(i, v)
+		}
+	case ElemTypeInteger:
+		for i, s := range values {
+			if uint32(i) >= ctl.Info.Count {
+				break
+			}
+			n, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return fmt.Errorf("alsactl: invalid integer %q: %v", s, err)
+			}
+			val.SetLong(i, n)
+		}
+	case ElemTypeInteger64:
+		for i, s := range values {
+			if uint32(i) >= ctl.Info.Count {
+				break
+			}
+			n, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return fmt.Errorf("alsactl: invalid integer64 %q: %v", s, err)
+			}
+			val.SetInt64(i, n)
+		}
+	case ElemTypeEnumerated:
+		for i, s := range values {
+			if uint32(i) >= ctl.Info.Count {
+				break
+			}
+			n, err := strconv.ParseUint(s, 10, 32)
+			if err != nil {
+				idx, found := c.findEnumIndex(ctl, s)
+				if !found {
+					return fmt.Errorf("alsactl: enum value %q not found", s)
+				}
+				n = uint64(idx)
+			}
+			val.SetUint32(i, uint32(n))
+		}
+	case ElemTypeBytes, ElemTypeIEC958:
+		for i, s := range values {
+			if i >= len(val.Value) {
+				break
+			}
+			b, err := strconv.ParseUint(s, 16, 8)
+			if err != nil {
+				return fmt.Errorf("alsactl: invalid hex byte %q: %v", s, err)
+			}
+			val.SetByte(i, byte(b))
+		}
+	default:
+		return ErrWrongType
+	}
+
+	return c.ioctl(sndrvCtlIoctlElemWrite, unsafe.Pointer(&val))
+}
@@ -0,0 +1,77 @@
+package alsactl
+
+import "encoding/binary"
+
+// ElemID — snd_ctl_elem_id, 64 bytes.
+type ElemID struct {
+	Numid     uint32
+	Iface     uint32
+	Device    uint32
+	Subdevice uint32
+	Name      [44]byte
+	Index     uint32
+}
+
+func (id *ElemID) nameStr() string {
+	n := 0
+	for n < len(id.Name) && id.Name[n] != 0 {
+		n++
+	}
+	return string(id.Name[:n])
+}
+
+// ElemInfo — snd_ctl_elem_info, 272 bytes.
+// Kernel layout: id(64) + type/access/count/pid(16) + value_union(128) + dimen+reserved(64)
+// The value union contains:
+//
+//	ENUMERATED: items(4) + item(4) + name[64] + names_ptr(8) + names_length(4) + pad
+//	INTEGER: min(long) + max(long) + step(long) — see readLong/writeLong
+type ElemInfo struct {
+	ID     ElemID // 64
+	Type   uint32
+	Access uint32
+	Count  uint32
+	Pid    int32
+	Union  [128]byte // value union: enum items/item/name, or integer min/max/step
+	_rest  [272 - 64 - 4*4 - 128]byte
+}
+
+// enumItems returns the item count from the value union's enumerated arm.
+func (info *ElemInfo) enumItems() uint32 {
+	return binary.LittleEndian.Uint32(info.Union[0:4])
+}
+
+// IntMin/IntMax read the first two `long` words of the value union's
+// integer arm — see readLong for the arch-dependent word width.
+func (info *ElemInfo) IntMin() int64 {
+	return readLong(info.Union[:], 0)
+}
+
+func (info *ElemInfo) IntMax() int64 {
+	return readLong(info.Union[:], 1)
+}
+
+// Control is a control resolved against a *Card: its stable identity
+// (ElemID) plus the ElemInfo snapshot taken when it was looked up.
+type Control struct {
+	ID   ElemID
+	Info ElemInfo
+}
+
+// Name is the control's name, e.g. "Headphone Volume".
+func (ctl Control) Name() string { return ctl.ID.nameStr() }
+
+// Numid is the control's numeric ID, stable for the lifetime of the card.
+func (ctl Control) Numid() uint32 { return ctl.ID.Numid }
+
+// Index distinguishes same-named controls, e.g. per-channel "PGA Gain".
+func (ctl Control) Index() uint32 { return ctl.ID.Index }
+
+// Type is one of the ElemType* constants.
+func (ctl Control) Type() uint32 { return ctl.Info.Type }
+
+// Writable reports whether the control accepts Set.
+func (ctl Control) Writable() bool { return ctl.Info.Access&AccessWrite != 0 }
+
+// HasTLV reports whether the control advertises a readable TLV (dB info).
+func (ctl Control) HasTLV() bool { return ctl.Info.Access&AccessTLVRead != 0 }
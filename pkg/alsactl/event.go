@@ -0,0 +1,77 @@
+package alsactl
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+// Event is a decoded snd_ctl_event (the elem-changed variant):
+// type(4) + mask(4) + id(64).
+type Event struct {
+	Type uint32
+	Mask uint32
+	ID   ElemID
+}
+
+// Name is the event's control name, e.g. "Headphone Volume".
+func (ev Event) Name() string { return ev.ID.nameStr() }
+
+// MaskString decodes an elem event's mask into "ADD,VALUE"-style text.
+func MaskString(mask uint32) string {
+	if mask == EventMaskRemove {
+		return "REMOVE"
+	}
+	var bits []string
+	if mask&EventMaskValue != 0 {
+		bits = append(bits, "VALUE")
+	}
+	if mask&EventMaskInfo != 0 {
+		bits = append(bits, "INFO")
+	}
+	if mask&EventMaskAdd != 0 {
+		bits = append(bits, "ADD")
+	}
+	if mask&EventMaskTLV != 0 {
+		bits = append(bits, "TLV")
+	}
+	if len(bits) == 0 {
+		return fmt.Sprintf("0x%x", mask)
+	}
+	return strings.Join(bits, ",")
+}
+
+// SubscribeEvents enables or disables delivery of control-change events on
+// the card's fd, consumed via ReadEvent.
+func (c *Card) SubscribeEvents(subscribe bool) error {
+	v := int32(0)
+	if subscribe {
+		v = 1
+	}
+	return c.ioctl(sndrvCtlIoctlSubscribeEvents, unsafe.Pointer(&v))
+}
+
+// ReadEvent blocks until one control-change event is available and decodes
+// it. The card must have events subscribed via SubscribeEvents(true).
+func (c *Card) ReadEvent() (Event, error) {
+	var buf [72]byte // type(4) + mask(4) + ElemID(64)
+	n, err := syscall.Read(c.fd, buf[:])
+	if err != nil {
+		return Event{}, err
+	}
+	if n < len(buf) {
+		return Event{}, fmt.Errorf("alsactl: short event read: %d bytes", n)
+	}
+	var ev Event
+	ev.Type = binary.LittleEndian.Uint32(buf[0:4])
+	ev.Mask = binary.LittleEndian.Uint32(buf[4:8])
+	ev.ID.Numid = binary.LittleEndian.Uint32(buf[8:12])
+	ev.ID.Iface = binary.LittleEndian.Uint32(buf[12:16])
+	ev.ID.Device = binary.LittleEndian.Uint32(buf[16:20])
+	ev.ID.Subdevice = binary.LittleEndian.Uint32(buf[20:24])
+	copy(ev.ID.Name[:], buf[24:68])
+	ev.ID.Index = binary.LittleEndian.Uint32(buf[68:72])
+	return ev, nil
+}
@@ -0,0 +1,81 @@
+package alsactl
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+// ALSA ioctl numbers shared by every arch: none of these structs change size
+// between 32- and 64-bit userspace (snd_ctl_elem_info's `long` integer
+// fields sit inside its fixed 128-byte value union). ELEM_LIST/ELEM_READ/ELEM_WRITE do change — see
+// layout_64bit.go / layout_arm.go.
+// _IOC(dir, type, nr, size) = (dir << 30) | (size << 16) | (type << 8) | nr
+// ALSA control type = 'U' = 0x55
+const (
+	// _IOR('U', 0x01, snd_ctl_card_info) — 376 bytes
+	sndrvCtlIoctlCardInfo = 0x81785501
+	// _IOWR('U', 0x11, snd_ctl_elem_info) — 272 bytes
+	sndrvCtlIoctlElemInfo = 0xC1105511
+	// _IOWR('U', 0x1a, snd_ctl_tlv) — header is numid+length (8 bytes);
+	// the tlv[] payload rides along in the same buffer we pass in.
+	sndrvCtlIoctlTLVRead = 0xC008551A
+	// _IOWR('U', 0x16, int)
+	sndrvCtlIoctlSubscribeEvents = 0xC0045516
+)
+
+// Element types (snd_ctl_elem_type_t).
+const (
+	ElemTypeNone       = 0
+	ElemTypeBoolean    = 1
+	ElemTypeInteger    = 2
+	ElemTypeEnumerated = 3
+	ElemTypeBytes      = 4
+	ElemTypeIEC958     = 5
+	ElemTypeInteger64  = 6
+)
+
+var typeNames = []string{"NONE", "BOOL", "INT", "ENUM", "BYTES", "IEC958", "INT64"}
+
+// TypeName returns the short display name for an element type, e.g. "INT".
+func TypeName(t uint32) string {
+	if int(t) < len(typeNames) {
+		return typeNames[t]
+	}
+	return fmt.Sprintf("TYPE_%d", t)
+}
+
+// Element access flags (snd_ctl_elem_info.access). Only the bits this
+// package actually needs are defined here.
+const (
+	AccessRead    = 1 << 0
+	AccessWrite   = 1 << 1
+	AccessTLVRead = 0x40
+)
+
+// TLV (Type-Length-Value) content types, as used by ReadTLV.
+// See sound/core/control_compat.c and include/uapi/sound/tlv.h upstream.
+const (
+	TLVTypeDBScale  = 1
+	TLVTypeDBLinear = 2
+	TLVTypeDBMinMax = 4
+)
+
+// snd_ctl_event types and the elem event's mask bits.
+const (
+	EventElem = 0
+
+	EventMaskValue  = 1 << 0
+	EventMaskInfo   = 1 << 1
+	EventMaskAdd    = 1 << 2
+	EventMaskTLV    = 1 << 3
+	EventMaskRemove = 0xffffffff
+)
+
+func ioctl(fd int, req uint, arg unsafe.Pointer) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd), uintptr(req), uintptr(arg))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
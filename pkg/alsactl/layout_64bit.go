@@ -0,0 +1,74 @@
+//go:build !arm
+
+// Struct layouts and ioctl numbers for 64-bit userspace (aarch64, amd64).
+// `long` is 8 bytes here, which is what drives every size in this file —
+// see layout_arm.go for the 32-bit mirror and control_compat.c upstream for
+// how the kernel translates between the two ABIs.
+//
+// This is resolved with a build tag rather than a descriptor table chosen
+// at startup: sizeof(long) can't change at runtime on a given binary, so
+// the per-arch constants and readLong/writeLong pair here are already
+// "resolved once for the running arch" — just by the Go toolchain at
+// compile time instead of by an init() doing runtime.GOARCH switches. A
+// descriptor table would buy dispatch flexibility this package has no use
+// for, at the cost of an indirection on every value access.
+package alsactl
+
+import (
+	"encoding/binary"
+	"unsafe"
+)
+
+// ALSA ioctl numbers, 64-bit userspace.
+// _IOC(dir, type, nr, size) = (dir << 30) | (size << 16) | (type << 8) | nr
+const (
+	// _IOWR('U', 0x10, snd_ctl_elem_list) — 80 bytes on 64-bit
+	sndrvCtlIoctlElemList = 0xC0505510
+	// _IOWR('U', 0x12, snd_ctl_elem_value) — 1224 bytes on 64-bit
+	// (long value[128] = 1024 bytes on aarch64)
+	sndrvCtlIoctlElemRead = 0xC4C85512
+	// _IOWR('U', 0x13, snd_ctl_elem_value) — 1224 bytes on 64-bit
+	sndrvCtlIoctlElemWrite = 0xC4C85513
+)
+
+// ctlLongSize is sizeof(long) for the running arch: it is the stride of
+// every BOOLEAN/INTEGER channel slot in ElemValue.Value and of the
+// INTEGER min/max/step fields in ElemInfo.Union.
+const ctlLongSize = 8
+
+// elemList — snd_ctl_elem_list, 80 bytes on 64-bit.
+type elemList struct {
+	Offset  uint32
+	Space   uint32
+	Used    uint32
+	Count   uint32
+	PidsPtr uint64 // pointer to ElemID array
+	_       [80 - 4*4 - 8]byte
+}
+
+func setListPids(list *elemList, ids []ElemID) {
+	list.PidsPtr = uint64(uintptr(unsafe.Pointer(&ids[0])))
+}
+
+// ElemValue — snd_ctl_elem_value, 1224 bytes on 64-bit (aarch64).
+// Layout: id(64) + indirect(4) + pad(4) + value_union(1024) + tstamp+reserved(128)
+// The value union is 1024 bytes because long value[128] on 64-bit.
+// BOOLEAN/INTEGER use long (8 bytes each), ENUMERATED uses uint (4 bytes each).
+type ElemValue struct {
+	ID       ElemID     // 64
+	Indirect uint32     // 4
+	_pad     uint32     // 4 (alignment padding to 8)
+	Value    [1024]byte // 1024 (value union: long value[128] on 64-bit)
+	_rest    [128]byte  // 128 (struct timespec + reserved = 128 always)
+}
+
+// readLong/writeLong access one BOOLEAN/INTEGER channel slot in buf
+// (ElemValue.Value or ElemInfo.Union), whose element width is sizeof(long)
+// on the running arch.
+func readLong(buf []byte, i int) int64 {
+	return int64(binary.LittleEndian.Uint64(buf[i*ctlLongSize:]))
+}
+
+func writeLong(buf []byte, i int, v int64) {
+	binary.LittleEndian.PutUint64(buf[i*ctlLongSize:], uint64(v))
+}
@@ -0,0 +1,72 @@
+//go:build arm
+
+// Struct layouts and ioctl numbers for 32-bit ARM userspace (e.g. 32-bit
+// Android). `long` and pointers are 4 bytes here, which shrinks
+// snd_ctl_elem_value's value union from 1024 to 512 bytes and the elem_list
+// pointer field from 8 to 4 bytes — both ripple into the _IOC size and so
+// into the ioctl request numbers below. See layout_64bit.go for the 64-bit
+// mirror and sound/core/control_compat.c upstream for the kernel's own
+// 32/64-bit translation of these same fields.
+package alsactl
+
+import (
+	"encoding/binary"
+	"unsafe"
+)
+
+// ALSA ioctl numbers, 32-bit ARM userspace.
+// _IOC(dir, type, nr, size) = (dir << 30) | (size << 16) | (type << 8) | nr
+const (
+	// _IOWR('U', 0x10, snd_ctl_elem_list) — 72 bytes on 32-bit
+	// (reserved[50] is fixed-size; only the pids pointer shrinks to 4 bytes)
+	sndrvCtlIoctlElemList = 0xC0485510
+	// _IOWR('U', 0x12, snd_ctl_elem_value) — 712 bytes on 32-bit
+	// (long value[128] = 512 bytes on a 32-bit long)
+	sndrvCtlIoctlElemRead = 0xC2C85512
+	// _IOWR('U', 0x13, snd_ctl_elem_value) — 712 bytes on 32-bit
+	sndrvCtlIoctlElemWrite = 0xC2C85513
+)
+
+// ctlLongSize is sizeof(long) for the running arch: it is the stride of
+// every BOOLEAN/INTEGER channel slot in ElemValue.Value and of the
+// INTEGER min/max/step fields in ElemInfo.Union.
+const ctlLongSize = 4
+
+// elemList — snd_ctl_elem_list, 72 bytes on 32-bit (pids is a 32-bit pointer).
+type elemList struct {
+	Offset  uint32
+	Space   uint32
+	Used    uint32
+	Count   uint32
+	PidsPtr uint32 // pointer to ElemID array
+	_       [72 - 4*4 - 4]byte
+}
+
+func setListPids(list *elemList, ids []ElemID) {
+	list.PidsPtr = uint32(uintptr(unsafe.Pointer(&ids[0])))
+}
+
+// ElemValue — snd_ctl_elem_value, 712 bytes on 32-bit ARM.
+// Layout: id(64) + indirect(4) + pad(4) + value_union(512) + tstamp+reserved(128).
+// As on 64-bit, indirect needs a trailing pad: the value union holds the
+// `long long` value64 arm, which 32-bit ARM aligns to 8 bytes.
+// BOOLEAN/INTEGER use long (4 bytes each), ENUMERATED uses uint (4 bytes
+// each, unchanged from 64-bit).
+type ElemValue struct {
+	ID       ElemID    // 64
+	Indirect uint32    // 4
+	_pad     uint32    // 4 (alignment padding to 8)
+	Value    [512]byte // 512 (value union: long value[128] on 32-bit)
+	_rest    [128]byte // 128 (struct timespec + reserved = 128 always)
+}
+
+// readLong/writeLong access one BOOLEAN/INTEGER channel slot in buf
+// (ElemValue.Value or ElemInfo.Union), whose element width is sizeof(long)
+// on the running arch.
+func readLong(buf []byte, i int) int64 {
+	return int64(int32(binary.LittleEndian.Uint32(buf[i*ctlLongSize:])))
+}
+
+func writeLong(buf []byte, i int, v int64) {
+	binary.LittleEndian.PutUint32(buf[i*ctlLongSize:], uint32(int32(v)))
+}
@@ -0,0 +1,18 @@
+//go:build arm
+
+package alsactl
+
+import "testing"
+
+func TestReadWriteLongARM(t *testing.T) {
+	cases := []int64{0, 1, -1, 42, -42, 1<<31 - 1, -(1 << 31)}
+	buf := make([]byte, ctlLongSize*len(cases))
+	for i, v := range cases {
+		writeLong(buf, i, v)
+	}
+	for i, want := range cases {
+		if got := readLong(buf, i); got != want {
+			t.Errorf("readLong(%d) = %d, want %d", i, got, want)
+		}
+	}
+}
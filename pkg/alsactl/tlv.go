@@ -0,0 +1,43 @@
+package alsactl
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// tlvMaxWords bounds the buffer we hand the kernel for TLV_READ. The TLVs
+// this package decodes (DB_SCALE, DB_LINEAR, DB_MINMAX) are a handful of
+// words; this leaves plenty of headroom without chasing a variable-length
+// ioctl.
+const tlvMaxWords = 256
+
+// ctlTLV — snd_ctl_tlv. The header is numid+length, followed by the tlv[]
+// payload itself: type, length (bytes), then the value words.
+type ctlTLV struct {
+	Numid  uint32
+	Length uint32
+	Data   [tlvMaxWords]uint32
+}
+
+// ReadTLV issues SNDRV_CTL_IOCTL_TLV_READ for ctl and returns the decoded
+// TLV type (one of the TLVType* constants) and its value words.
+func (c *Card) ReadTLV(ctl Control) (tlvType uint32, payload []uint32, err error) {
+	if !ctl.HasTLV() {
+		return 0, nil, ErrWrongType
+	}
+	var tlv ctlTLV
+	tlv.Numid = ctl.ID.Numid
+	tlv.Length = uint32(len(tlv.Data) * 4)
+	if err := c.ioctl(sndrvCtlIoctlTLVRead, unsafe.Pointer(&tlv)); err != nil {
+		return 0, nil, err
+	}
+	if tlv.Length < 8 {
+		return 0, nil, fmt.Errorf("alsactl: TLV read: short reply (%d bytes)", tlv.Length)
+	}
+	tlvType = tlv.Data[0]
+	words := int(tlv.Data[1]) / 4
+	if max := len(tlv.Data) - 2; words > max {
+		words = max
+	}
+	return tlvType, tlv.Data[2 : 2+words], nil
+}
@@ -0,0 +1,38 @@
+package alsactl
+
+import "encoding/binary"
+
+// Long returns channel i of a BOOLEAN/INTEGER value. Channels are packed
+// as `long`, whose width is arch-dependent — see readLong.
+func (v *ElemValue) Long(i int) int64 { return readLong(v.Value[:], i) }
+
+// SetLong writes channel i of a BOOLEAN/INTEGER value.
+func (v *ElemValue) SetLong(i int, x int64) { writeLong(v.Value[:], i, x) }
+
+// Int64 returns channel i of an INTEGER64 value. Unlike Long, this is
+// always 8 bytes wide on every arch: the kernel's value64 union arm is an
+// explicit `long long`, not a native `long`.
+func (v *ElemValue) Int64(i int) int64 {
+	return int64(binary.LittleEndian.Uint64(v.Value[i*8:]))
+}
+
+// SetInt64 writes channel i of an INTEGER64 value.
+func (v *ElemValue) SetInt64(i int, x int64) {
+	binary.LittleEndian.PutUint64(v.Value[i*8:], uint64(x))
+}
+
+// Uint32 returns channel i of an ENUMERATED value, always 4 bytes wide.
+func (v *ElemValue) Uint32(i int) uint32 {
+	return binary.LittleEndian.Uint32(v.Value[i*4:])
+}
+
+// SetUint32 writes channel i of an ENUMERATED value.
+func (v *ElemValue) SetUint32(i int, x uint32) {
+	binary.LittleEndian.PutUint32(v.Value[i*4:], x)
+}
+
+// Byte returns raw byte i of a BYTES or IEC958 value.
+func (v *ElemValue) Byte(i int) byte { return v.Value[i] }
+
+// SetByte writes raw byte i of a BYTES or IEC958 value.
+func (v *ElemValue) SetByte(i int, b byte) { v.Value[i] = b }